@@ -0,0 +1,65 @@
+package freecurrencyapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError is returned for any non-2xx response and carries whatever detail
+// freecurrencyapi's error envelope ({"message": "...", "errors": {...}})
+// included, alongside the raw body for callers that want to inspect it
+// themselves.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Errors     map[string][]string
+	RawBody    []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("freecurrencyapi: %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("freecurrencyapi: unexpected status code %d", e.StatusCode)
+}
+
+// Is lets callers keep using errors.Is(err, ErrUnauthorized) and
+// errors.Is(err, ErrInvalidStatusCode) against an *APIError.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrInvalidStatusCode:
+		return e.StatusCode != http.StatusOK
+	default:
+		return false
+	}
+}
+
+// newAPIError builds an APIError from a non-2xx response, decoding the
+// freecurrencyapi error envelope when the body matches it. The caller
+// remains responsible for closing res.Body.
+func newAPIError(res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return &APIError{StatusCode: res.StatusCode}
+	}
+
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RawBody:    body,
+	}
+
+	var envelope struct {
+		Message string              `json:"message"`
+		Errors  map[string][]string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiErr.Message = envelope.Message
+		apiErr.Errors = envelope.Errors
+	}
+
+	return apiErr
+}
@@ -0,0 +1,174 @@
+package freecurrencyapi
+
+import (
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache lets callers plug in their own response cache (in-memory, Redis,
+// etc.) for Latest, Currencies, and Historical. A ttl <= 0 passed to Set
+// means the entry never expires on its own.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, val []byte, ttl time.Duration)
+}
+
+type lruCacheEntry struct {
+	key       string
+	val       []byte
+	expiresAt time.Time
+}
+
+// lruCache is the default in-memory Cache, evicting the least recently
+// used entry once capacity is exceeded.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+// NewLRUCache returns an in-memory Cache holding at most capacity entries.
+// A capacity <= 0 falls back to 1000.
+func NewLRUCache(capacity int) Cache {
+	if capacity <= 0 {
+		capacity = 1000
+	}
+
+	return &lruCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+func (c *lruCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*lruCacheEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+
+	return entry.val, true
+}
+
+func (c *lruCache) Set(key string, val []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruCacheEntry).val = val
+		el.Value.(*lruCacheEntry).expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&lruCacheEntry{key: key, val: val, expiresAt: expiresAt})
+	c.entries[key] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*lruCacheEntry).key)
+		}
+	}
+}
+
+// doCachedRequest fetches endpoint's raw JSON body, serving it from v.cache
+// when present and fresh, and coalescing concurrent identical requests via
+// v.sfGroup so a burst only reaches the network once.
+func (v *v2Client) doCachedRequest(ctx context.Context, endpoint string, query map[string]string) ([]byte, error) {
+	if v.cache == nil {
+		return v.fetchBody(ctx, endpoint, query)
+	}
+
+	key := cacheKey(endpoint, query)
+
+	if body, ok := v.cache.Get(key); ok {
+		return body, nil
+	}
+
+	body, err, _ := v.sfGroup.Do(key, func() (interface{}, error) {
+		return v.fetchBody(ctx, endpoint, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBytes := body.([]byte)
+	v.cache.Set(key, bodyBytes, v.cacheTTL[endpoint])
+
+	return bodyBytes, nil
+}
+
+// fetchBody issues the request and returns the raw response body, or an
+// *APIError for a non-2xx status. If a quota guard is configured, it
+// refuses the request up front with ErrQuotaExhausted instead of reaching
+// the network once the remaining monthly quota is too low.
+func (v *v2Client) fetchBody(ctx context.Context, endpoint string, query map[string]string) ([]byte, error) {
+	if v.quotaGuard != nil {
+		if err := v.quotaGuard.allow(ctx, v.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := v.doRequest(ctx, http.MethodGet, endpoint, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res)
+	}
+
+	if v.quotaGuard != nil {
+		v.quotaGuard.recordUsage()
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// cacheKey builds a stable key from the endpoint and its query params,
+// independent of map iteration order.
+func cacheKey(endpoint string, query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(endpoint)
+	for _, k := range keys {
+		b.WriteByte('?')
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(query[k])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// CacheOptions configures NewCachingClient. A nil Cache defaults to
+// NewLRUCache(0), an in-memory LRU holding at most 1000 entries; zero TTLs
+// fall back to DefaultCacheTTL's values for the matching endpoint.
+type CacheOptions struct {
+	Cache         Cache
+	LatestTTL     time.Duration
+	CurrenciesTTL time.Duration
+	HistoricalTTL time.Duration
+}
+
+// cachingClient wraps any Client with response caching for Latest,
+// Currencies, and Historical, coalescing concurrent identical calls so a
+// burst against the free tier's monthly quota only reaches the network
+// once. Range, HistoricalRange, Status, and the Convert helpers pass
+// straight through to inner and are not cached by this layer: they call
+// inner's own Latest, Currencies, and Historical, not the cached ones above.
+type cachingClient struct {
+	inner   Client
+	cache   Cache
+	ttl     map[string]time.Duration
+	sfGroup singleflight.Group
+}
+
+// NewCachingClient wraps inner with a caching layer. It is an alternative
+// to ClientOption.WithCache for callers who build their own Client (or want
+// caching independent of how inner was constructed).
+func NewCachingClient(inner Client, opts CacheOptions) Client {
+	cache := opts.Cache
+	if cache == nil {
+		cache = NewLRUCache(0)
+	}
+
+	ttl := map[string]time.Duration{
+		"latest":     opts.LatestTTL,
+		"currencies": opts.CurrenciesTTL,
+		"historical": opts.HistoricalTTL,
+	}
+	for endpoint, defaultTTL := range DefaultCacheTTL {
+		if ttl[endpoint] == 0 {
+			ttl[endpoint] = defaultTTL
+		}
+	}
+
+	return &cachingClient{inner: inner, cache: cache, ttl: ttl}
+}
+
+func (c *cachingClient) Latest(ctx context.Context, request LatestRequest) (LatestResponse, error) {
+	var response LatestResponse
+	err := c.cached(ctx, "latest", request.toParams(), &response, func() (interface{}, error) {
+		return c.inner.Latest(ctx, request)
+	})
+	return response, err
+}
+
+func (c *cachingClient) Currencies(ctx context.Context, request CurrenciesRequest) (CurrenciesResponse, error) {
+	var response CurrenciesResponse
+	err := c.cached(ctx, "currencies", request.toParams(), &response, func() (interface{}, error) {
+		return c.inner.Currencies(ctx, request)
+	})
+	return response, err
+}
+
+func (c *cachingClient) Historical(ctx context.Context, request HistoricalRequest) (HistoricalResponse, error) {
+	var response HistoricalResponse
+	err := c.cached(ctx, "historical", request.toParams(), &response, func() (interface{}, error) {
+		return c.inner.Historical(ctx, request)
+	})
+	return response, err
+}
+
+func (c *cachingClient) Range(ctx context.Context, request RangeRequest) (RangeResponse, error) {
+	return c.inner.Range(ctx, request)
+}
+
+func (c *cachingClient) HistoricalRange(ctx context.Context, request HistoricalRangeRequest) (HistoricalRangeResponse, error) {
+	return c.inner.HistoricalRange(ctx, request)
+}
+
+func (c *cachingClient) Status(ctx context.Context) (StatusResponse, error) {
+	return c.inner.Status(ctx)
+}
+
+func (c *cachingClient) Convert(ctx context.Context, request ConvertRequest) (ConvertResponse, error) {
+	return c.inner.Convert(ctx, request)
+}
+
+func (c *cachingClient) ConvertTo(ctx context.Context, amount float64, from string, at time.Time, to ...string) (map[string]float64, error) {
+	return c.inner.ConvertTo(ctx, amount, from, at, to...)
+}
+
+func (c *cachingClient) ConvertBatch(ctx context.Context, amounts map[string]float64, from string, to string, at time.Time) (map[string]float64, error) {
+	return c.inner.ConvertBatch(ctx, amounts, from, to, at)
+}
+
+func (c *cachingClient) ValuePortfolio(ctx context.Context, holdings map[string]float64, quote string) (map[string]float64, float64, error) {
+	return c.inner.ValuePortfolio(ctx, holdings, quote)
+}
+
+// cached decodes a cached response into out when present, otherwise calls
+// fetch (coalesced across concurrent identical keys) and caches its result.
+func (c *cachingClient) cached(ctx context.Context, endpoint string, params map[string]string, out interface{}, fetch func() (interface{}, error)) error {
+	key := cacheKey(endpoint, params)
+
+	if body, ok := c.cache.Get(key); ok {
+		return json.Unmarshal(body, out)
+	}
+
+	result, err, _ := c.sfGroup.Do(key, func() (interface{}, error) {
+		return fetch()
+	})
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	c.cache.Set(key, body, c.ttl[endpoint])
+
+	return json.Unmarshal(body, out)
+}
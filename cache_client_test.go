@@ -0,0 +1,57 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	latestCalls int32
+}
+
+func (f *fakeClient) Latest(ctx context.Context, request LatestRequest) (LatestResponse, error) {
+	atomic.AddInt32(&f.latestCalls, 1)
+	return LatestResponse{Rates: map[string]float64{"AED": 3.67306}}, nil
+}
+func (f *fakeClient) Currencies(ctx context.Context, request CurrenciesRequest) (CurrenciesResponse, error) {
+	return CurrenciesResponse{}, nil
+}
+func (f *fakeClient) Historical(ctx context.Context, request HistoricalRequest) (HistoricalResponse, error) {
+	return HistoricalResponse{}, nil
+}
+func (f *fakeClient) Range(ctx context.Context, request RangeRequest) (RangeResponse, error) {
+	return RangeResponse{}, nil
+}
+func (f *fakeClient) HistoricalRange(ctx context.Context, request HistoricalRangeRequest) (HistoricalRangeResponse, error) {
+	return HistoricalRangeResponse{}, nil
+}
+func (f *fakeClient) Status(ctx context.Context) (StatusResponse, error) { return StatusResponse{}, nil }
+func (f *fakeClient) Convert(ctx context.Context, request ConvertRequest) (ConvertResponse, error) {
+	return ConvertResponse{}, nil
+}
+func (f *fakeClient) ConvertTo(ctx context.Context, amount float64, from string, at time.Time, to ...string) (map[string]float64, error) {
+	return nil, nil
+}
+func (f *fakeClient) ConvertBatch(ctx context.Context, amounts map[string]float64, from string, to string, at time.Time) (map[string]float64, error) {
+	return nil, nil
+}
+func (f *fakeClient) ValuePortfolio(ctx context.Context, holdings map[string]float64, quote string) (map[string]float64, float64, error) {
+	return nil, 0, nil
+}
+
+func Test_NewCachingClient(t *testing.T) {
+	inner := &fakeClient{}
+	cli := NewCachingClient(inner, CacheOptions{})
+
+	for i := 0; i < 5; i++ {
+		response, err := cli.Latest(context.Background(), LatestRequest{})
+		assert.Nil(t, err, "expected error to be nil")
+		assert.Equal(t, LatestResponse{Rates: map[string]float64{"AED": 3.67306}}, response, "expected response to be equal")
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&inner.latestCalls), "expected the inner client to be hit only once")
+}
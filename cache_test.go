@@ -0,0 +1,61 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("a", []byte("1"), time.Hour)
+	cache.Set("b", []byte("2"), time.Hour)
+	cache.Set("c", []byte("3"), time.Hour) // evicts "a", the least recently used
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok, "expected the oldest entry to be evicted")
+
+	val, ok := cache.Get("b")
+	assert.True(t, ok, "expected b to still be cached")
+	assert.Equal(t, []byte("2"), val, "expected cached value to be equal")
+
+	cache.Set("expired", []byte("4"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	_, ok = cache.Get("expired")
+	assert.False(t, ok, "expected expired entry to be gone")
+}
+
+func Test_FreeCurrencyAPIv2_CacheCoalescing(t *testing.T) {
+	var requests int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"AED":3.67306}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().
+		WithHTTPClient(testServer.Client()).
+		WithBaseURL(testServer.URL+"/v1/").
+		WithCache(NewLRUCache(10)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cli.Latest(context.Background(), LatestRequest{})
+			assert.Nil(t, err, "expected error to be nil")
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&requests), int32(2), "expected concurrent identical requests to be coalesced")
+}
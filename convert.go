@@ -0,0 +1,184 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Convert fetches the From->To rate (latest, or as of At when it is set)
+// and returns the converted amount both raw and rounded to the To
+// currency's conventions.
+func (v *v2Client) Convert(ctx context.Context, request ConvertRequest) (ConvertResponse, error) {
+	rate, err := v.rateFor(ctx, request.From, []string{request.To}, request.At)
+	if err != nil {
+		return ConvertResponse{}, err
+	}
+
+	currencies, err := v.getCurrencies(ctx)
+	if err != nil {
+		return ConvertResponse{}, err
+	}
+
+	raw := request.Amount * rate[request.To]
+
+	return ConvertResponse{
+		Rate:            rate[request.To],
+		RawAmount:       raw,
+		ConvertedAmount: roundAmount(raw, currencies.Currencies[request.To]),
+	}, nil
+}
+
+// ConvertTo converts amount from `from` into every currency in to, fetching
+// every rate in a single request instead of one Convert call per target.
+func (v *v2Client) ConvertTo(ctx context.Context, amount float64, from string, at time.Time, to ...string) (map[string]float64, error) {
+	if len(to) == 0 {
+		return map[string]float64{}, nil
+	}
+
+	rates, err := v.rateFor(ctx, from, to, at)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies, err := v.getCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make(map[string]float64, len(to))
+	for _, code := range to {
+		converted[code] = roundAmount(amount*rates[code], currencies.Currencies[code])
+	}
+
+	return converted, nil
+}
+
+// ConvertBatch converts every amount in amounts (keyed by an arbitrary
+// caller-chosen id, e.g. an order id) from `from` to `to`, fetching the
+// exchange rate only once no matter how many amounts are supplied.
+func (v *v2Client) ConvertBatch(ctx context.Context, amounts map[string]float64, from string, to string, at time.Time) (map[string]float64, error) {
+	rate, err := v.rateFor(ctx, from, []string{to}, at)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies, err := v.getCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item := currencies.Currencies[to]
+
+	converted := make(map[string]float64, len(amounts))
+	for id, amount := range amounts {
+		converted[id] = roundAmount(amount*rate[to], item)
+	}
+
+	return converted, nil
+}
+
+// ValuePortfolio converts every balance in holdings (keyed by currency
+// code) into quote, returning the per-currency valuations and their sum.
+// It fetches quote's rate against every holding currency in a single
+// Latest call, the same "sum balances x price" shape as valuing a crypto
+// portfolio, but for fiat. A holding whose currency has no rate in that
+// response is left out of both the valuations and the total.
+func (v *v2Client) ValuePortfolio(ctx context.Context, holdings map[string]float64, quote string) (map[string]float64, float64, error) {
+	if len(holdings) == 0 {
+		return map[string]float64{}, 0, nil
+	}
+
+	codes := make([]string, 0, len(holdings))
+	for code := range holdings {
+		codes = append(codes, code)
+	}
+
+	rates, err := v.Latest(ctx, LatestRequest{BaseCurrency: quote, Currencies: codes})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	currencies, err := v.getCurrencies(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	quoteItem := currencies.Currencies[quote]
+
+	values := make(map[string]float64, len(holdings))
+	var total float64
+	for code, balance := range holdings {
+		rate := 1.0
+		if code != quote {
+			var ok bool
+			rate, ok = rates.Rates[code]
+			if !ok {
+				continue
+			}
+		}
+
+		value := roundAmount(balance/rate, quoteItem)
+		values[code] = value
+		total += value
+	}
+
+	return values, roundAmount(total, quoteItem), nil
+}
+
+// rateFor returns the From->To rate(s), using Latest when at is the zero
+// value and Historical otherwise.
+func (v *v2Client) rateFor(ctx context.Context, from string, to []string, at time.Time) (map[string]float64, error) {
+	if at.IsZero() {
+		res, err := v.Latest(ctx, LatestRequest{BaseCurrency: from, Currencies: to})
+		if err != nil {
+			return nil, err
+		}
+		return res.Rates, nil
+	}
+
+	res, err := v.Historical(ctx, HistoricalRequest{Date: at, BaseCurrency: from, Currencies: to})
+	if err != nil {
+		return nil, err
+	}
+	return res.Rates, nil
+}
+
+// getCurrencies returns the cached CurrenciesResponse, refreshing it if it
+// is missing or older than metadataCacheTTL.
+func (v *v2Client) getCurrencies(ctx context.Context) (CurrenciesResponse, error) {
+	v.metadataMu.Lock()
+	if !v.metadataCachedAt.IsZero() && time.Since(v.metadataCachedAt) < v.metadataCacheTTL {
+		cached := v.metadataCache
+		v.metadataMu.Unlock()
+		return cached, nil
+	}
+	v.metadataMu.Unlock()
+
+	res, err := v.Currencies(ctx, CurrenciesRequest{})
+	if err != nil {
+		return CurrenciesResponse{}, err
+	}
+
+	v.metadataMu.Lock()
+	v.metadataCache = res
+	v.metadataCachedAt = time.Now()
+	v.metadataMu.Unlock()
+
+	return res, nil
+}
+
+// roundAmount rounds amount to item's DecimalDigits, then snaps it to the
+// nearest item.Rounding increment at that precision (e.g. Rounding: 5 at
+// DecimalDigits: 2 rounds to the nearest 0.05).
+func roundAmount(amount float64, item CurrencyItem) float64 {
+	scale := math.Pow(10, float64(item.DecimalDigits))
+	rounded := math.Round(amount*scale) / scale
+
+	if item.Rounding > 0 {
+		step := float64(item.Rounding) / scale
+		rounded = math.Round(rounded/step) * step
+	}
+
+	return rounded
+}
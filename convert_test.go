@@ -0,0 +1,101 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FreeCurrencyAPIv2_Convert(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v1/latest":
+			w.Write([]byte(`{"data":{"EUR":0.9}}`))
+		case "/v1/currencies":
+			w.Write([]byte(`{"data":{"EUR":{"code":"EUR","decimal_digits":2,"rounding":0}}}`))
+		}
+	})
+
+	testServer := httptest.NewServer(handler)
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+
+	response, err := cli.Convert(context.Background(), ConvertRequest{Amount: 100, From: "USD", To: "EUR"})
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, ConvertResponse{Rate: 0.9, RawAmount: 90, ConvertedAmount: 90}, response, "expected response to be equal")
+}
+
+func Test_FreeCurrencyAPIv2_ConvertTo(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v1/latest":
+			w.Write([]byte(`{"data":{"EUR":0.9,"GBP":0.8}}`))
+		case "/v1/currencies":
+			w.Write([]byte(`{"data":{"EUR":{"code":"EUR","decimal_digits":2,"rounding":0},"GBP":{"code":"GBP","decimal_digits":2,"rounding":0}}}`))
+		}
+	})
+
+	testServer := httptest.NewServer(handler)
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+
+	converted, err := cli.ConvertTo(context.Background(), 100, "USD", time.Time{}, "EUR", "GBP")
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, map[string]float64{"EUR": 90, "GBP": 80}, converted, "expected converted amounts to be equal")
+}
+
+func Test_FreeCurrencyAPIv2_ValuePortfolio(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v1/latest":
+			// base_currency=USD, so these are "EUR/GBP per 1 USD" style rates.
+			w.Write([]byte(`{"data":{"EUR":0.5,"GBP":0.25}}`))
+		case "/v1/currencies":
+			w.Write([]byte(`{"data":{"USD":{"code":"USD","decimal_digits":2,"rounding":0}}}`))
+		}
+	})
+
+	testServer := httptest.NewServer(handler)
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+
+	values, total, err := cli.ValuePortfolio(context.Background(), map[string]float64{"EUR": 10, "GBP": 5}, "USD")
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, map[string]float64{"EUR": 20, "GBP": 20}, values, "expected per-currency valuations to be equal")
+	assert.Equal(t, float64(40), total, "expected total to be equal")
+}
+
+func Test_FreeCurrencyAPIv2_ValuePortfolio_HoldingInQuoteCurrency(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/v1/latest":
+			// /latest omits the base currency from its own rates map, so a
+			// USD holding must not be treated as "missing" just because
+			// rates["USD"] isn't in the response.
+			w.Write([]byte(`{"data":{"EUR":0.5}}`))
+		case "/v1/currencies":
+			w.Write([]byte(`{"data":{"USD":{"code":"USD","decimal_digits":2,"rounding":0}}}`))
+		}
+	})
+
+	testServer := httptest.NewServer(handler)
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+
+	values, total, err := cli.ValuePortfolio(context.Background(), map[string]float64{"EUR": 10, "USD": 15}, "USD")
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, map[string]float64{"EUR": 20, "USD": 15}, values, "expected the USD holding to be valued at rate 1, not dropped")
+	assert.Equal(t, float64(35), total, "expected total to include the USD holding")
+}
@@ -1,13 +1,23 @@
 package freecurrencyapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
 )
 
+// BaseUrl is the default API endpoint used when a ClientOption does not set
+// BaseURL.
+const BaseUrl = "https://api.freecurrencyapi.com/v1/"
+
 var (
 	ErrInvalidStatusCode = errors.New("invalid status code")
 	ErrUnauthorized      = errors.New("unauthorized")
@@ -17,88 +27,78 @@ type Client interface {
 	Latest(ctx context.Context, request LatestRequest) (LatestResponse, error)
 	Currencies(ctx context.Context, request CurrenciesRequest) (CurrenciesResponse, error)
 	Historical(ctx context.Context, request HistoricalRequest) (HistoricalResponse, error)
+	Range(ctx context.Context, request RangeRequest) (RangeResponse, error)
+	HistoricalRange(ctx context.Context, request HistoricalRangeRequest) (HistoricalRangeResponse, error)
 	Status(ctx context.Context) (StatusResponse, error)
+	Convert(ctx context.Context, request ConvertRequest) (ConvertResponse, error)
+	ConvertTo(ctx context.Context, amount float64, from string, at time.Time, to ...string) (map[string]float64, error)
+	ConvertBatch(ctx context.Context, amounts map[string]float64, from string, to string, at time.Time) (map[string]float64, error)
+	ValuePortfolio(ctx context.Context, holdings map[string]float64, quote string) (map[string]float64, float64, error)
 }
 
 type v2Client struct {
-	apiKey     string
-	httpClient *http.Client
-	baseURL    string
+	apiKey         string
+	httpClient     *http.Client
+	baseURL        string
+	maxConcurrency int
+	dateStep       time.Duration
+	retryPolicy    RetryPolicy
+
+	metadataCacheTTL time.Duration
+	metadataMu       sync.Mutex
+	metadataCachedAt time.Time
+	metadataCache    CurrenciesResponse
+
+	cache    Cache
+	cacheTTL map[string]time.Duration
+	sfGroup  singleflight.Group
+
+	quotaGuard *quotaGuard
 }
 
-func (v v2Client) Latest(ctx context.Context, request LatestRequest) (LatestResponse, error) {
-	res, err := v.doRequest(ctx, http.MethodGet, "latest", nil, request.toParams())
+func (v *v2Client) Latest(ctx context.Context, request LatestRequest) (LatestResponse, error) {
+	body, err := v.doCachedRequest(ctx, "latest", request.toParams())
 	if err != nil {
 		return LatestResponse{}, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusUnauthorized {
-			return LatestResponse{}, ErrUnauthorized
-		}
-		return LatestResponse{}, ErrInvalidStatusCode
-	}
-
 	var decodedResponse latestResponse
-	err = json.NewDecoder(res.Body).Decode(&decodedResponse)
-	if err != nil {
+	if err := json.Unmarshal(body, &decodedResponse); err != nil {
 		return LatestResponse{}, err
 	}
 
 	return decodedResponse.toLatestResponse(), nil
 }
 
-func (v v2Client) Currencies(ctx context.Context, request CurrenciesRequest) (CurrenciesResponse, error) {
-	res, err := v.doRequest(ctx, http.MethodGet, "currencies", nil, request.toParams())
+func (v *v2Client) Currencies(ctx context.Context, request CurrenciesRequest) (CurrenciesResponse, error) {
+	body, err := v.doCachedRequest(ctx, "currencies", request.toParams())
 	if err != nil {
 		return CurrenciesResponse{}, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusUnauthorized {
-			return CurrenciesResponse{}, ErrUnauthorized
-		}
-		return CurrenciesResponse{}, ErrInvalidStatusCode
-	}
-
 	var decodedResponse currenciesResponse
-	err = json.NewDecoder(res.Body).Decode(&decodedResponse)
-	if err != nil {
+	if err := json.Unmarshal(body, &decodedResponse); err != nil {
 		return CurrenciesResponse{}, err
 	}
 
 	return decodedResponse.toCurrenciesResponse(), nil
 }
 
-func (v v2Client) Historical(ctx context.Context, request HistoricalRequest) (HistoricalResponse, error) {
-	res, err := v.doRequest(ctx, http.MethodGet, "historical", nil, request.toParams())
+func (v *v2Client) Historical(ctx context.Context, request HistoricalRequest) (HistoricalResponse, error) {
+	body, err := v.doCachedRequest(ctx, "historical", request.toParams())
 	if err != nil {
 		return HistoricalResponse{}, err
 	}
 
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusUnauthorized {
-			return HistoricalResponse{}, ErrUnauthorized
-		}
-		return HistoricalResponse{}, ErrInvalidStatusCode
-	}
-
 	var decodedResponse historicalResponse
-	err = json.NewDecoder(res.Body).Decode(&decodedResponse)
-	if err != nil {
+	if err := json.Unmarshal(body, &decodedResponse); err != nil {
 		return HistoricalResponse{}, err
 	}
 
-	return decodedResponse.toHistoricalResponse(), nil
+	return decodedResponse.toHistoricalResponse(request.Date.Format("2006-01-02")), nil
 }
 
-func (v v2Client) Status(ctx context.Context) (StatusResponse, error) {
+func (v *v2Client) Status(ctx context.Context) (StatusResponse, error) {
 	res, err := v.doRequest(ctx, http.MethodGet, "status", nil, nil)
 	if err != nil {
 		return StatusResponse{}, err
@@ -106,10 +106,7 @@ func (v v2Client) Status(ctx context.Context) (StatusResponse, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusUnauthorized {
-			return StatusResponse{}, ErrUnauthorized
-		}
-		return StatusResponse{}, ErrInvalidStatusCode
+		return StatusResponse{}, newAPIError(res)
 	}
 
 	var decodedResponse statusResponse
@@ -121,32 +118,111 @@ func (v v2Client) Status(ctx context.Context) (StatusResponse, error) {
 	return decodedResponse.toStatusResponse(), nil
 }
 
-func (v v2Client) doRequest(ctx context.Context, method string, path string, body io.Reader, query map[string]string) (*http.Response, error) {
-	url := v.baseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
-	if err != nil {
-		return nil, err
+// doRequest issues the request, retrying transient failures according to
+// v.retryPolicy. With the zero-value policy it behaves exactly as a single
+// attempt always has.
+func (v *v2Client) doRequest(ctx context.Context, method string, path string, body io.Reader, query map[string]string) (*http.Response, error) {
+	var bodyBytes []byte
+	if body != nil {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes = b
 	}
 
-	req.Header.Set("apikey", v.apiKey)
-	req.Header.Set("Content-Type", "application/json")
+	url := v.baseURL + path
+	maxAttempts := v.retryPolicy.maxAttempts()
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("apikey", v.apiKey)
+		req.Header.Set("Content-Type", "application/json")
 
-	q := req.URL.Query()
-	for k, v := range query {
-		q.Add(k, v)
+		q := req.URL.Query()
+		for k, val := range query {
+			q.Add(k, val)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := v.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			if attempt == maxAttempts {
+				break
+			}
+			if waitErr := sleepForRetry(ctx, v.retryPolicy.backoff(attempt)); waitErr != nil {
+				return nil, waitErr
+			}
+			continue
+		}
+
+		if attempt == maxAttempts || !v.retryPolicy.isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := parseRetryAfter(resp.Header.Get("Retry-After"))
+		resp.Body.Close()
+		if wait <= 0 {
+			wait = v.retryPolicy.backoff(attempt)
+		}
+		if waitErr := sleepForRetry(ctx, wait); waitErr != nil {
+			return nil, waitErr
+		}
 	}
-	req.URL.RawQuery = q.Encode()
-	resp, err := v.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+
+	if maxAttempts <= 1 {
+		return nil, lastErr
 	}
 
-	return resp, nil
+	return nil, fmt.Errorf("%w: %w", ErrRetriesExhausted, lastErr)
+}
+
+// DefaultMaxConcurrency bounds how many Range requests fan out to the
+// Historical endpoint at once when the caller does not set WithMaxConcurrency.
+const DefaultMaxConcurrency = 5
+
+// DefaultMetadataCacheTTL is how long Convert, ConvertTo, and ConvertBatch
+// reuse a fetched CurrenciesResponse before refreshing it, when the caller
+// does not set WithMetadataCacheTTL.
+const DefaultMetadataCacheTTL = time.Hour
+
+// DefaultCacheTTL holds the per-endpoint TTLs used when a Cache is set via
+// WithCache but the caller does not override them with WithCacheTTL. A
+// duration of 0 means the entry never expires on its own, which is why
+// "historical" (rates for a past date never change) defaults to it.
+var DefaultCacheTTL = map[string]time.Duration{
+	"latest":     time.Minute,
+	"currencies": 24 * time.Hour,
+	"historical": 0,
 }
 
 type ClientOption struct {
-	HTTPClient *http.Client
-	BaseURL    string
+	HTTPClient        *http.Client
+	BaseURL           string
+	MaxConcurrency    int
+	DateStep          time.Duration
+	RetryPolicy       RetryPolicy
+	MetadataCacheTTL  time.Duration
+	Cache             Cache
+	CacheTTL          map[string]time.Duration
+	Middlewares       []Middleware
+	QuotaGuardEnabled bool
+	QuotaGuardMin     int
 }
 
 func (c *ClientOption) WithHTTPClient(httpClient *http.Client) *ClientOption {
@@ -159,6 +235,83 @@ func (c *ClientOption) WithBaseURL(baseURL string) *ClientOption {
 	return c
 }
 
+// WithMaxConcurrency bounds how many Historical requests Range fans out at
+// once. Values <= 0 fall back to DefaultMaxConcurrency.
+func (c *ClientOption) WithMaxConcurrency(maxConcurrency int) *ClientOption {
+	c.MaxConcurrency = maxConcurrency
+	return c
+}
+
+// WithDateStep controls the stride Range walks between From and To, e.g.
+// 7*24*time.Hour for weekly sampling instead of the daily default.
+func (c *ClientOption) WithDateStep(dateStep time.Duration) *ClientOption {
+	c.DateStep = dateStep
+	return c
+}
+
+// WithRetry configures how doRequest retries transient network errors and
+// retryable HTTP statuses (5xx, 429, and any RetryPolicy.RetryableStatusCodes).
+func (c *ClientOption) WithRetry(retryPolicy RetryPolicy) *ClientOption {
+	c.RetryPolicy = retryPolicy
+	return c
+}
+
+// WithSimpleRetry is a shorthand for WithRetry(NewRetryPolicy(maxRetries,
+// baseDelay)): retry up to maxRetries times, doubling baseDelay with full
+// jitter after each attempt. For finer control (MaxBackoff,
+// RetryableStatusCodes, ...) use WithRetry with a RetryPolicy directly.
+func (c *ClientOption) WithSimpleRetry(maxRetries int, baseDelay time.Duration) *ClientOption {
+	return c.WithRetry(NewRetryPolicy(maxRetries, baseDelay))
+}
+
+// WithMetadataCacheTTL controls how long Convert and friends reuse a
+// fetched CurrenciesResponse before refreshing it. Values <= 0 fall back to
+// DefaultMetadataCacheTTL.
+func (c *ClientOption) WithMetadataCacheTTL(ttl time.Duration) *ClientOption {
+	c.MetadataCacheTTL = ttl
+	return c
+}
+
+// WithCache enables response caching for Latest, Currencies, and
+// Historical (Status always bypasses the cache so quota reporting stays
+// live). Concurrent identical requests are coalesced so only one reaches
+// the network. See DefaultCacheTTL for the per-endpoint TTLs used unless
+// overridden with WithCacheTTL.
+func (c *ClientOption) WithCache(cache Cache) *ClientOption {
+	c.Cache = cache
+	return c
+}
+
+// WithCacheTTL overrides DefaultCacheTTL's per-endpoint TTLs. Keys are
+// "latest", "currencies", and "historical"; a duration of 0 means the
+// entry never expires on its own.
+func (c *ClientOption) WithCacheTTL(ttl map[string]time.Duration) *ClientOption {
+	c.CacheTTL = ttl
+	return c
+}
+
+// WithMiddleware wraps every request the client makes through the given
+// middlewares, outermost first, ahead of whatever Transport the HTTPClient
+// already carries (http.DefaultTransport if none was set). See
+// LoggingMiddleware, MetricsMiddleware, TracingMiddleware, and
+// QuotaTrackingMiddleware for the built-ins.
+func (c *ClientOption) WithMiddleware(middlewares ...Middleware) *ClientOption {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
+}
+
+// WithQuotaGuard makes every call other than Status that would reach the
+// network fail fast with ErrQuotaExhausted, without reaching it, once the
+// client's locally tracked remaining monthly quota drops below
+// minRemaining; responses already in a Cache set via WithCache are still
+// served. The guard seeds and periodically refreshes its view of the quota
+// from Status, and decrements it locally after every successful request.
+func (c *ClientOption) WithQuotaGuard(minRemaining int) *ClientOption {
+	c.QuotaGuardEnabled = true
+	c.QuotaGuardMin = minRemaining
+	return c
+}
+
 func Options() *ClientOption {
 	return &ClientOption{}
 }
@@ -179,9 +332,51 @@ func NewClient(apiKey string, opts ...*ClientOption) Client {
 		baseURL = BaseUrl
 	}
 
+	maxConcurrency := opt.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultMaxConcurrency
+	}
+
+	dateStep := opt.DateStep
+	if dateStep <= 0 {
+		dateStep = 24 * time.Hour
+	}
+
+	metadataCacheTTL := opt.MetadataCacheTTL
+	if metadataCacheTTL <= 0 {
+		metadataCacheTTL = DefaultMetadataCacheTTL
+	}
+
+	cacheTTL := opt.CacheTTL
+	if cacheTTL == nil {
+		cacheTTL = DefaultCacheTTL
+	}
+
+	if len(opt.Middlewares) > 0 {
+		base := httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		clientCopy := *httpClient
+		clientCopy.Transport = chainMiddleware(base, opt.Middlewares)
+		httpClient = &clientCopy
+	}
+
+	var guard *quotaGuard
+	if opt.QuotaGuardEnabled {
+		guard = newQuotaGuard(opt.QuotaGuardMin)
+	}
+
 	return &v2Client{
-		apiKey:     apiKey,
-		httpClient: httpClient,
-		baseURL:    baseURL,
+		apiKey:           apiKey,
+		httpClient:       httpClient,
+		baseURL:          baseURL,
+		maxConcurrency:   maxConcurrency,
+		dateStep:         dateStep,
+		retryPolicy:      opt.RetryPolicy,
+		metadataCacheTTL: metadataCacheTTL,
+		cache:            opt.Cache,
+		cacheTTL:         cacheTTL,
+		quotaGuard:       guard,
 	}
 }
@@ -48,7 +48,7 @@ func Test_FreeCurrencyAPIv2_Status(t *testing.T) {
 			apiKey: "",
 			assert: func(t *testing.T, response StatusResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, StatusResponse{}, response, "expected response to be empty")
 			},
@@ -58,7 +58,7 @@ func Test_FreeCurrencyAPIv2_Status(t *testing.T) {
 			apiKey: "invalid-api-key",
 			assert: func(t *testing.T, response StatusResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, StatusResponse{}, response, "expected response to be empty")
 			},
@@ -68,7 +68,7 @@ func Test_FreeCurrencyAPIv2_Status(t *testing.T) {
 			apiKey: sampleApiKey,
 			assert: func(t *testing.T, response StatusResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrInvalidStatusCode, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrInvalidStatusCode, "expected error to be ErrInvalidStatusCode")
 
 				assert.Equal(t, StatusResponse{}, response, "expected response to be empty")
 			},
@@ -76,6 +76,23 @@ func Test_FreeCurrencyAPIv2_Status(t *testing.T) {
 				w.WriteHeader(http.StatusInternalServerError)
 			}),
 		},
+		{
+			name:   "should return a structured APIError for a 422 validation response",
+			apiKey: sampleApiKey,
+			assert: func(t *testing.T, response StatusResponse, err error) {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr, "expected err to be an *APIError")
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode, "expected status code to be equal")
+				assert.Equal(t, "The given data was invalid.", apiErr.Message, "expected message to be equal")
+				assert.Equal(t, map[string][]string{"base_currency": {"The selected base currency is invalid."}}, apiErr.Errors, "expected errors to be equal")
+
+				assert.Equal(t, StatusResponse{}, response, "expected response to be empty")
+			},
+			serverHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"The given data was invalid.","errors":{"base_currency":["The selected base currency is invalid."]}}`))
+			}),
+		},
 		{
 			name:   "should return error if client.Do fails",
 			apiKey: sampleApiKey,
@@ -167,7 +184,7 @@ func Test_FreeCurrencyAPIv2_Currencies(t *testing.T) {
 			apiKey: "",
 			assert: func(t *testing.T, response CurrenciesResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, CurrenciesResponse{}, response, "expected response to be empty")
 			},
@@ -177,7 +194,7 @@ func Test_FreeCurrencyAPIv2_Currencies(t *testing.T) {
 			apiKey: "invalid-api-key",
 			assert: func(t *testing.T, response CurrenciesResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, CurrenciesResponse{}, response, "expected response to be empty")
 			},
@@ -187,7 +204,7 @@ func Test_FreeCurrencyAPIv2_Currencies(t *testing.T) {
 			apiKey: sampleApiKey,
 			assert: func(t *testing.T, response CurrenciesResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrInvalidStatusCode, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrInvalidStatusCode, "expected error to be ErrInvalidStatusCode")
 
 				assert.Equal(t, CurrenciesResponse{}, response, "expected response to be empty")
 			},
@@ -195,6 +212,23 @@ func Test_FreeCurrencyAPIv2_Currencies(t *testing.T) {
 				w.WriteHeader(http.StatusInternalServerError)
 			}),
 		},
+		{
+			name:   "should return a structured APIError for a 422 validation response",
+			apiKey: sampleApiKey,
+			assert: func(t *testing.T, response CurrenciesResponse, err error) {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr, "expected err to be an *APIError")
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode, "expected status code to be equal")
+				assert.Equal(t, "The given data was invalid.", apiErr.Message, "expected message to be equal")
+				assert.Equal(t, map[string][]string{"currencies": {"The selected currencies is invalid."}}, apiErr.Errors, "expected errors to be equal")
+
+				assert.Equal(t, CurrenciesResponse{}, response, "expected response to be empty")
+			},
+			serverHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"The given data was invalid.","errors":{"currencies":["The selected currencies is invalid."]}}`))
+			}),
+		},
 		{
 			name:   "should return error if client.Do fails",
 			apiKey: sampleApiKey,
@@ -300,7 +334,7 @@ func Test_FreeCurrencyAPIv2_Latest(t *testing.T) {
 			apiKey: "",
 			assert: func(t *testing.T, response LatestResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, LatestResponse{}, response, "expected response to be empty")
 			},
@@ -310,7 +344,7 @@ func Test_FreeCurrencyAPIv2_Latest(t *testing.T) {
 			apiKey: "invalid-api-key",
 			assert: func(t *testing.T, response LatestResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, LatestResponse{}, response, "expected response to be empty")
 			},
@@ -320,7 +354,7 @@ func Test_FreeCurrencyAPIv2_Latest(t *testing.T) {
 			apiKey: sampleApiKey,
 			assert: func(t *testing.T, response LatestResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrInvalidStatusCode, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrInvalidStatusCode, "expected error to be ErrInvalidStatusCode")
 
 				assert.Equal(t, LatestResponse{}, response, "expected response to be empty")
 			},
@@ -328,6 +362,23 @@ func Test_FreeCurrencyAPIv2_Latest(t *testing.T) {
 				w.WriteHeader(http.StatusInternalServerError)
 			}),
 		},
+		{
+			name:   "should return a structured APIError for a 422 validation response",
+			apiKey: sampleApiKey,
+			assert: func(t *testing.T, response LatestResponse, err error) {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr, "expected err to be an *APIError")
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode, "expected status code to be equal")
+				assert.Equal(t, "The given data was invalid.", apiErr.Message, "expected message to be equal")
+				assert.Equal(t, map[string][]string{"base_currency": {"The selected base currency is invalid."}}, apiErr.Errors, "expected errors to be equal")
+
+				assert.Equal(t, LatestResponse{}, response, "expected response to be empty")
+			},
+			serverHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"The given data was invalid.","errors":{"base_currency":["The selected base currency is invalid."]}}`))
+			}),
+		},
 		{
 			name:   "should return error if client.Do fails",
 			apiKey: sampleApiKey,
@@ -403,7 +454,7 @@ func Test_FreeCurrencyAPIv2_Latest(t *testing.T) {
 }
 
 func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
-	mockResponse := `{"data":{"2022-01-01":{"AED":3.67306,"AFN":91.80254,"ALL":108.22904,"AMD":480.41659}}}`
+	mockResponse := `{"data":{"0001-01-01":{"AED":3.67306,"AFN":91.80254,"ALL":108.22904,"AMD":480.41659}}}`
 
 	defaultHandler := baseServerHandler(http.MethodGet, "/v1/historical", sampleApiKey, mockResponse)
 	cases := []struct {
@@ -419,7 +470,7 @@ func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
 			apiKey: "",
 			assert: func(t *testing.T, response HistoricalResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, HistoricalResponse{}, response, "expected response to be empty")
 			},
@@ -429,7 +480,7 @@ func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
 			apiKey: "invalid-api-key",
 			assert: func(t *testing.T, response HistoricalResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrUnauthorized, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrUnauthorized, "expected error to be ErrUnauthorized")
 
 				assert.Equal(t, HistoricalResponse{}, response, "expected response to be empty")
 			},
@@ -439,7 +490,7 @@ func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
 			apiKey: sampleApiKey,
 			assert: func(t *testing.T, response HistoricalResponse, err error) {
 				assert.NotNil(t, err, "expected error to be not nil")
-				assert.Equal(t, ErrInvalidStatusCode, err, "expected error to be ErrInvalidStatusCode")
+				assert.ErrorIs(t, err, ErrInvalidStatusCode, "expected error to be ErrInvalidStatusCode")
 
 				assert.Equal(t, HistoricalResponse{}, response, "expected response to be empty")
 			},
@@ -447,6 +498,23 @@ func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
 				w.WriteHeader(http.StatusInternalServerError)
 			}),
 		},
+		{
+			name:   "should return a structured APIError for a 422 validation response",
+			apiKey: sampleApiKey,
+			assert: func(t *testing.T, response HistoricalResponse, err error) {
+				var apiErr *APIError
+				assert.ErrorAs(t, err, &apiErr, "expected err to be an *APIError")
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode, "expected status code to be equal")
+				assert.Equal(t, "The given data was invalid.", apiErr.Message, "expected message to be equal")
+				assert.Equal(t, map[string][]string{"date": {"The date is not a valid date."}}, apiErr.Errors, "expected errors to be equal")
+
+				assert.Equal(t, HistoricalResponse{}, response, "expected response to be empty")
+			},
+			serverHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"The given data was invalid.","errors":{"date":["The date is not a valid date."]}}`))
+			}),
+		},
 		{
 			name:   "should return error if client.Do fails",
 			apiKey: sampleApiKey,
@@ -465,7 +533,7 @@ func Test_FreeCurrencyAPIv2_Historical(t *testing.T) {
 				assert.NotNil(t, err, "expected error to be not nil")
 				assert.Equal(t, HistoricalResponse{}, response, "expected response to be empty")
 			},
-			serverHandler: baseServerHandler(http.MethodGet, "/v1/historical", sampleApiKey, `{"data":{"2022-01-01":{"AED":3.67306`),
+			serverHandler: baseServerHandler(http.MethodGet, "/v1/historical", sampleApiKey, `{"data":{"0001-01-01":{"AED":3.67306`),
 		},
 		{
 			name:   "should return error if context is canceled",
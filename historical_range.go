@@ -0,0 +1,37 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// HistoricalRange fetches the same date window as Range, but returns it as
+// an ordered time series instead of a map, which is the shape charting and
+// backtesting callers want.
+func (v *v2Client) HistoricalRange(ctx context.Context, request HistoricalRangeRequest) (HistoricalRangeResponse, error) {
+	rangeResponse, err := v.Range(ctx, RangeRequest{
+		From:         request.From,
+		To:           request.To,
+		BaseCurrency: request.BaseCurrency,
+		Currencies:   request.Currencies,
+	})
+	if err != nil {
+		return HistoricalRangeResponse{}, err
+	}
+
+	days := make([]HistoricalDay, 0, len(rangeResponse.Rates))
+	for dateStr, rates := range rangeResponse.Rates {
+		date, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return HistoricalRangeResponse{}, err
+		}
+		days = append(days, HistoricalDay{Date: date, Rates: rates})
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	return HistoricalRangeResponse{Days: days}, nil
+}
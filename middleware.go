@@ -0,0 +1,219 @@
+package freecurrencyapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware wraps an http.RoundTripper to add cross-cutting behavior
+// (logging, metrics, tracing, ...) around every request the client makes.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// chainMiddleware wraps base with every middleware in order: the first
+// middleware in the slice is outermost, so it sees the request first and
+// the response last.
+func chainMiddleware(base http.RoundTripper, middlewares []Middleware) http.RoundTripper {
+	rt := base
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+// LoggingMiddleware logs each request's method, path, status code, and
+// duration to logger (at Error level if the transport itself failed).
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+			duration := time.Since(start)
+
+			if err != nil {
+				logger.Error("freecurrencyapi request failed", "method", req.Method, "path", req.URL.Path, "error", err, "duration", duration)
+				return res, err
+			}
+
+			logger.Info("freecurrencyapi request", "method", req.Method, "path", req.URL.Path, "status", res.StatusCode, "duration", duration)
+			return res, nil
+		})
+	}
+}
+
+// MetricsMiddleware registers (and records into) a request counter and a
+// latency histogram, both labeled by path and, for the counter, status code.
+// Building more than one MetricsMiddleware against the same Registerer (a
+// second client, a reloaded config, ...) reuses the already-registered
+// collectors instead of panicking.
+func MetricsMiddleware(reg prometheus.Registerer) Middleware {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "freecurrencyapi_requests_total",
+		Help: "Total freecurrencyapi HTTP requests by path and status code.",
+	}, []string{"path", "status"})
+	if c, err := registerOrReuse(reg, requests); err == nil {
+		requests = c.(*prometheus.CounterVec)
+	}
+
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "freecurrencyapi_request_duration_seconds",
+		Help: "freecurrencyapi HTTP request latency by path.",
+	}, []string{"path"})
+	if c, err := registerOrReuse(reg, latency); err == nil {
+		latency = c.(*prometheus.HistogramVec)
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+
+			status := "error"
+			if res != nil {
+				status = strconv.Itoa(res.StatusCode)
+			}
+			requests.WithLabelValues(req.URL.Path, status).Inc()
+			latency.WithLabelValues(req.URL.Path).Observe(time.Since(start).Seconds())
+
+			return res, err
+		})
+	}
+}
+
+// registerOrReuse registers c against reg, returning the collector already
+// registered under the same descriptor instead of erroring if one exists.
+func registerOrReuse(reg prometheus.Registerer, c prometheus.Collector) (prometheus.Collector, error) {
+	if err := reg.Register(c); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return are.ExistingCollector, nil
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// TracingMiddleware starts a span around every request using tracer,
+// recording the status code and any transport error on it.
+func TracingMiddleware(tracer trace.Tracer) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(req.Context(), "freecurrencyapi."+strings.Trim(req.URL.Path, "/"))
+			defer span.End()
+
+			res, err := next.RoundTrip(req.WithContext(ctx))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return res, err
+			}
+
+			span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+			return res, nil
+		})
+	}
+}
+
+// QuotaGauge is satisfied by a prometheus.Gauge, letting
+// QuotaTrackingMiddleware stay agnostic of the metrics backend.
+// Implementations must be safe for concurrent use: QuotaTrackingMiddleware
+// calls Set from both its background poll goroutine and the foreground
+// RoundTripper at the same time, same as a real prometheus.Gauge already
+// guarantees.
+type QuotaGauge interface {
+	Set(float64)
+}
+
+// DefaultQuotaPollInterval is how often QuotaTrackingMiddleware polls the
+// status endpoint on its own when the caller does not set an interval.
+const DefaultQuotaPollInterval = 5 * time.Minute
+
+// QuotaTrackingMiddleware polls the status endpoint every interval (on its
+// own goroutine, stopped when ctx is done) and reports
+// StatusResponse.Month.Remaining on gauge, so quota can be graphed even if
+// the application never calls Status itself. It also opportunistically
+// updates gauge from any status response it observes passing through,
+// rather than waiting for the next tick — so gauge.Set can be called from
+// either goroutine concurrently; see QuotaGauge. apiKey and baseURL mirror
+// the values passed to NewClient, since polling happens below the Client.
+func QuotaTrackingMiddleware(ctx context.Context, gauge QuotaGauge, apiKey string, baseURL string, interval time.Duration) Middleware {
+	if interval <= 0 {
+		interval = DefaultQuotaPollInterval
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		poll := func() {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"status", nil)
+			if err != nil {
+				return
+			}
+			req.Header.Set("apikey", apiKey)
+
+			res, err := next.RoundTrip(req)
+			if err != nil || res == nil {
+				return
+			}
+			defer res.Body.Close()
+			if res.StatusCode != http.StatusOK {
+				return
+			}
+
+			var decoded statusResponse
+			if json.NewDecoder(res.Body).Decode(&decoded) == nil {
+				gauge.Set(float64(decoded.Quotas.Month.Remaining))
+			}
+		}
+
+		go func() {
+			poll()
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					poll()
+				}
+			}
+		}()
+
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			res, err := next.RoundTrip(req)
+			if err != nil || res == nil || res.StatusCode != http.StatusOK || !strings.HasSuffix(req.URL.Path, "status") {
+				return res, err
+			}
+
+			body, readErr := io.ReadAll(res.Body)
+			res.Body.Close()
+			res.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return res, nil
+			}
+
+			var decoded statusResponse
+			if json.Unmarshal(body, &decoded) == nil {
+				gauge.Set(float64(decoded.Quotas.Month.Remaining))
+			}
+
+			return res, nil
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ChainMiddleware(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"AED":3.67306}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	httpClient := *testServer.Client()
+	httpClient.Transport = chainMiddleware(httpClient.Transport, []Middleware{trace("outer"), trace("inner")})
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(&httpClient).WithBaseURL(testServer.URL+"/v1/"))
+	_, err := cli.Latest(context.Background(), LatestRequest{})
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, []string{"outer", "inner"}, order, "expected the outermost middleware to run first")
+}
+
+// fakeQuotaGauge guards value with a mutex because QuotaTrackingMiddleware
+// writes to it both from its background poll goroutine and from the
+// foreground RoundTripper, the same concurrency contract a real
+// prometheus.Gauge already satisfies.
+type fakeQuotaGauge struct {
+	mu    sync.Mutex
+	value float64
+}
+
+func (g *fakeQuotaGauge) Set(v float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.value = v
+}
+
+func (g *fakeQuotaGauge) Get() float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.value
+}
+
+func Test_QuotaTrackingMiddleware(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"quotas":{"month":{"total":5000,"used":100,"remaining":4900}}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	gauge := &fakeQuotaGauge{}
+	httpClient := *testServer.Client()
+	httpClient.Transport = chainMiddleware(httpClient.Transport, []Middleware{
+		QuotaTrackingMiddleware(context.Background(), gauge, sampleApiKey, testServer.URL+"/v1/", time.Hour),
+	})
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(&httpClient).WithBaseURL(testServer.URL+"/v1/"))
+	assert.Eventually(t, func() bool {
+		return gauge.Get() == 4900
+	}, time.Second, 10*time.Millisecond, "expected the background poll to report the remaining monthly quota")
+
+	_, err := cli.Status(context.Background())
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, float64(4900), gauge.Get(), "expected the gauge to still report the remaining monthly quota")
+}
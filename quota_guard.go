@@ -0,0 +1,71 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExhausted is returned instead of making a request once a
+// quotaGuard's locally tracked remaining quota drops below its threshold.
+var ErrQuotaExhausted = errors.New("monthly quota exhausted")
+
+// DefaultQuotaRefreshInterval is how often a quotaGuard re-synchronizes its
+// view of the remaining monthly quota against Status, on top of the
+// decrement it makes after every request that succeeds.
+const DefaultQuotaRefreshInterval = 5 * time.Minute
+
+// quotaGuard tracks a client's remaining monthly quota locally so calls can
+// fail fast with ErrQuotaExhausted instead of reaching the network once the
+// quota is nearly gone. It seeds and periodically refreshes its count via
+// statusFn (ordinarily v2Client.Status) and decrements it by one after
+// every allowed request that gets a 200 response.
+type quotaGuard struct {
+	minRemaining int
+
+	mu          sync.Mutex
+	remaining   int
+	haveQuota   bool
+	lastRefresh time.Time
+}
+
+func newQuotaGuard(minRemaining int) *quotaGuard {
+	return &quotaGuard{minRemaining: minRemaining}
+}
+
+// allow refreshes the local quota view from statusFn when it is stale (or
+// missing) and reports ErrQuotaExhausted if the remaining quota is below
+// minRemaining.
+func (g *quotaGuard) allow(ctx context.Context, statusFn func(ctx context.Context) (StatusResponse, error)) error {
+	g.mu.Lock()
+	stale := !g.haveQuota || time.Since(g.lastRefresh) >= DefaultQuotaRefreshInterval
+	g.mu.Unlock()
+
+	if stale {
+		if status, err := statusFn(ctx); err == nil {
+			g.mu.Lock()
+			g.remaining = status.Month.Remaining
+			g.haveQuota = true
+			g.lastRefresh = time.Now()
+			g.mu.Unlock()
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.haveQuota && g.remaining < g.minRemaining {
+		return ErrQuotaExhausted
+	}
+	return nil
+}
+
+// recordUsage decrements the locally tracked quota by one, so a burst of
+// requests within a single refresh window still sees an accurate count.
+func (g *quotaGuard) recordUsage() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.haveQuota && g.remaining > 0 {
+		g.remaining--
+	}
+}
@@ -0,0 +1,49 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FreeCurrencyAPIv2_QuotaGuard(t *testing.T) {
+	var statusCalls int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/v1/status" {
+			atomic.AddInt32(&statusCalls, 1)
+			w.Write([]byte(`{"quotas":{"month":{"total":5000,"used":4999,"remaining":1}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"AED":3.67306}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/").WithQuotaGuard(5))
+
+	_, err := cli.Latest(context.Background(), LatestRequest{})
+	assert.ErrorIs(t, err, ErrQuotaExhausted, "expected the guard to refuse the call when remaining quota is below the threshold")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&statusCalls), "expected the guard to have refreshed quota via Status")
+}
+
+func Test_FreeCurrencyAPIv2_QuotaGuard_AllowsWithinBudget(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/v1/status" {
+			w.Write([]byte(`{"quotas":{"month":{"total":5000,"used":10,"remaining":4990}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"AED":3.67306}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/").WithQuotaGuard(5))
+
+	response, err := cli.Latest(context.Background(), LatestRequest{})
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, LatestResponse{Rates: map[string]float64{"AED": 3.67306}}, response, "expected response to be equal")
+}
@@ -0,0 +1,94 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Range fans out one Historical call per date between request.From and
+// request.To (inclusive), stepping by the client's configured date step
+// (daily by default), and merges the results into a single response keyed
+// by date. Up to maxConcurrency calls run at once; if ctx is canceled,
+// pending calls are abandoned and the first error encountered is returned.
+func (v *v2Client) Range(ctx context.Context, request RangeRequest) (RangeResponse, error) {
+	dates := datesInRange(request.From, request.To, v.dateStep)
+	if len(dates) == 0 {
+		return RangeResponse{Rates: map[string]map[string]float64{}}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		sem      = make(chan struct{}, v.maxConcurrency)
+		rates    = make(map[string]map[string]float64, len(dates))
+		firstErr error
+	)
+
+	for _, date := range dates {
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+			break
+		}
+
+		wg.Add(1)
+		go func(date time.Time) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = ctx.Err()
+				}
+				mu.Unlock()
+				return
+			}
+
+			res, err := v.Historical(ctx, request.toHistoricalRequest(date))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				cancel()
+				return
+			}
+			rates[date.Format("2006-01-02")] = res.Rates
+		}(date)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return RangeResponse{}, firstErr
+	}
+
+	return RangeResponse{Rates: rates}, nil
+}
+
+// datesInRange returns every date from, from+step, ... up to and including
+// to. A non-positive step is treated as a single-day step.
+func datesInRange(from, to time.Time, step time.Duration) []time.Time {
+	if step <= 0 {
+		step = 24 * time.Hour
+	}
+
+	var dates []time.Time
+	for d := from; !d.After(to); d = d.Add(step) {
+		dates = append(dates, d)
+	}
+
+	return dates
+}
@@ -0,0 +1,94 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FreeCurrencyAPIv2_Range(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date := r.URL.Query().Get("date")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"` + date + `":{"AED":3.67306}}}`))
+	})
+
+	cases := []struct {
+		name   string
+		ctx    context.Context
+		assert func(t *testing.T, response RangeResponse, err error)
+	}{
+		{
+			name: "should return one historical response per date in range",
+			assert: func(t *testing.T, response RangeResponse, err error) {
+				assert.Nil(t, err, "expected error to be nil")
+				assert.Equal(t, RangeResponse{
+					Rates: map[string]map[string]float64{
+						"2022-01-01": {"AED": 3.67306},
+						"2022-01-02": {"AED": 3.67306},
+						"2022-01-03": {"AED": 3.67306},
+					},
+				}, response, "expected response to merge every date")
+			},
+		},
+		{
+			name: "should short-circuit on a canceled context",
+			assert: func(t *testing.T, response RangeResponse, err error) {
+				assert.NotNil(t, err, "expected error to be not nil")
+				assert.Equal(t, RangeResponse{}, response, "expected response to be empty")
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			}(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			testServer := httptest.NewServer(handler)
+			t.Cleanup(testServer.Close)
+
+			cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx
+			}
+
+			response, err := cli.Range(ctx, RangeRequest{From: from, To: to})
+			tc.assert(t, response, err)
+		})
+	}
+}
+
+func Test_FreeCurrencyAPIv2_HistoricalRange(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date := r.URL.Query().Get("date")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"` + date + `":{"AED":3.67306}}}`))
+	}))
+	t.Cleanup(testServer.Close)
+
+	cli := NewClient(sampleApiKey, Options().WithHTTPClient(testServer.Client()).WithBaseURL(testServer.URL+"/v1/"))
+
+	response, err := cli.HistoricalRange(context.Background(), HistoricalRangeRequest{From: from, To: to})
+	assert.Nil(t, err, "expected error to be nil")
+	assert.Equal(t, HistoricalRangeResponse{
+		Days: []HistoricalDay{
+			{Date: from, Rates: map[string]float64{"AED": 3.67306}},
+			{Date: from.AddDate(0, 0, 1), Rates: map[string]float64{"AED": 3.67306}},
+			{Date: from.AddDate(0, 0, 2), Rates: map[string]float64{"AED": 3.67306}},
+		},
+	}, response, "expected days to be sorted ascending by date")
+}
@@ -59,3 +59,40 @@ func (r HistoricalRequest) toParams() map[string]string {
 
 	return p
 }
+
+// RangeRequest describes a historical window to fetch, one day (or
+// WithDateStep increment) at a time, from From up to and including To.
+type RangeRequest struct {
+	From         time.Time
+	To           time.Time
+	BaseCurrency string
+	Currencies   []string
+}
+
+func (r RangeRequest) toHistoricalRequest(date time.Time) HistoricalRequest {
+	return HistoricalRequest{
+		Date:         date,
+		BaseCurrency: r.BaseCurrency,
+		Currencies:   r.Currencies,
+	}
+}
+
+// ConvertRequest describes a single currency conversion. When At is the
+// zero value, Convert uses the latest rate; otherwise it uses the rate on
+// that date.
+type ConvertRequest struct {
+	Amount float64
+	From   string
+	To     string
+	At     time.Time
+}
+
+// HistoricalRangeRequest describes the same historical window as
+// RangeRequest, but HistoricalRange returns it as an ordered time series
+// instead of a map.
+type HistoricalRangeRequest struct {
+	From         time.Time
+	To           time.Time
+	BaseCurrency string
+	Currencies   []string
+}
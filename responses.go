@@ -1,5 +1,7 @@
 package freecurrencyapi
 
+import "time"
+
 type latestResponse struct {
 	Data map[string]float64 `json:"data"`
 }
@@ -69,18 +71,44 @@ type HistoricalResponse struct {
 	Rates map[string]float64
 }
 
-func (r historicalResponse) toHistoricalResponse() HistoricalResponse {
-	firstKey := ""
-	for key := range r.Data {
-		firstKey = key
-		break
-	}
-
+// toHistoricalResponse looks up date (the "2006-01-02" string the request
+// asked for) directly, rather than picking an arbitrary key out of Data.
+func (r historicalResponse) toHistoricalResponse(date string) HistoricalResponse {
 	return HistoricalResponse{
-		Rates: r.Data[firstKey],
+		Rates: r.Data[date],
 	}
 }
 
+// RangeResponse holds each date's rates (what HistoricalResponse.Rates
+// holds for that date) across the requested window, keyed by the same
+// "2006-01-02" format used to request it.
+type RangeResponse struct {
+	Rates map[string]map[string]float64
+}
+
+// HistoricalDay is one date's rates within a HistoricalRangeResponse.
+type HistoricalDay struct {
+	Date  time.Time
+	Rates map[string]float64
+}
+
+// HistoricalRangeResponse is a time series of rates across the requested
+// window, Days sorted ascending by Date — suitable for charting or
+// backtesting without one call per day.
+type HistoricalRangeResponse struct {
+	Days []HistoricalDay
+}
+
+// ConvertResponse is the result of converting ConvertRequest.Amount at the
+// fetched Rate. ConvertedAmount is rounded per the target currency's
+// DecimalDigits/Rounding (see CurrenciesResponse); RawAmount is the
+// unrounded value.
+type ConvertResponse struct {
+	Rate            float64
+	RawAmount       float64
+	ConvertedAmount float64
+}
+
 type statusResponse struct {
 	Quotas struct {
 		Month struct {
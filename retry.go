@@ -0,0 +1,140 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrRetriesExhausted wraps the last transport error (or, via errors.Is, can
+// be checked alongside it) once doRequest has used up every attempt allowed
+// by RetryPolicy.
+var ErrRetriesExhausted = errors.New("retries exhausted")
+
+// RetryPolicy configures how doRequest retries transient failures. The
+// zero value disables retries, preserving single-attempt behavior.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values <= 1 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Defaults to
+	// 200ms if unset.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential growth of the delay. Defaults to
+	// 30s if unset.
+	MaxBackoff time.Duration
+	// Multiplier scales the backoff after each attempt. Defaults to 2.
+	Multiplier float64
+	// Jitter, when true, applies full jitter (a random delay between 0
+	// and the computed backoff) to avoid thundering-herd retries.
+	Jitter bool
+	// RetryableStatusCodes lets callers opt additional HTTP statuses into
+	// the retry loop on top of the built-in 429 and 5xx handling.
+	RetryableStatusCodes []int
+}
+
+// NewRetryPolicy builds a RetryPolicy for the common case: retry up to
+// maxRetries times, doubling baseDelay with full jitter after each attempt.
+// For finer control (MaxBackoff, RetryableStatusCodes, ...) construct a
+// RetryPolicy directly instead.
+func NewRetryPolicy(maxRetries int, baseDelay time.Duration) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    maxRetries + 1,
+		InitialBackoff: baseDelay,
+		Multiplier:     2,
+		Jitter:         true,
+	}
+}
+
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests || (statusCode >= 500 && statusCode <= 599) {
+		return true
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 200 * time.Millisecond
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	wait := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if wait > float64(maxBackoff) {
+		wait = float64(maxBackoff)
+	}
+
+	if p.Jitter {
+		wait = rand.Float64() * wait
+	}
+
+	return time.Duration(wait)
+}
+
+// parseRetryAfter understands both forms the Retry-After header can take: a
+// number of seconds, or an HTTP-date. It returns 0 if the header is absent,
+// malformed, or already in the past.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
+
+// sleepForRetry blocks for d, or returns ctx.Err() as soon as ctx is done,
+// whichever happens first.
+func sleepForRetry(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package freecurrencyapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FreeCurrencyAPIv2_Retry(t *testing.T) {
+	t.Run("should retry a 429 and honor Retry-After before succeeding", func(t *testing.T) {
+		attempts := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data":{"AED":3.67306}}`))
+		}))
+		t.Cleanup(testServer.Close)
+
+		cli := NewClient(sampleApiKey, Options().
+			WithHTTPClient(testServer.Client()).
+			WithBaseURL(testServer.URL+"/v1/").
+			WithRetry(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}))
+
+		response, err := cli.Latest(context.Background(), LatestRequest{})
+		assert.Nil(t, err, "expected error to be nil")
+		assert.Equal(t, 3, attempts, "expected the client to retry until it succeeded")
+		assert.Equal(t, LatestResponse{Rates: map[string]float64{"AED": 3.67306}}, response, "expected response to be equal")
+	})
+
+	t.Run("NewRetryPolicy should translate maxRetries into MaxAttempts", func(t *testing.T) {
+		policy := NewRetryPolicy(3, 200*time.Millisecond)
+		assert.Equal(t, 4, policy.MaxAttempts, "expected MaxAttempts to be maxRetries + 1")
+		assert.Equal(t, 200*time.Millisecond, policy.InitialBackoff, "expected InitialBackoff to be equal")
+		assert.True(t, policy.Jitter, "expected jitter to be enabled by default")
+	})
+
+	t.Run("WithSimpleRetry should retry using NewRetryPolicy's defaults", func(t *testing.T) {
+		attempts := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 2 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data":{"AED":3.67306}}`))
+		}))
+		t.Cleanup(testServer.Close)
+
+		cli := NewClient(sampleApiKey, Options().
+			WithHTTPClient(testServer.Client()).
+			WithBaseURL(testServer.URL+"/v1/").
+			WithSimpleRetry(2, time.Millisecond))
+
+		response, err := cli.Latest(context.Background(), LatestRequest{})
+		assert.Nil(t, err, "expected error to be nil")
+		assert.Equal(t, 2, attempts, "expected the client to retry until it succeeded")
+		assert.Equal(t, LatestResponse{Rates: map[string]float64{"AED": 3.67306}}, response, "expected response to be equal")
+	})
+
+	t.Run("should return ErrRetriesExhausted after using up every attempt", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		testServer.Close()
+
+		cli := NewClient(sampleApiKey, Options().
+			WithHTTPClient(testServer.Client()).
+			WithBaseURL(testServer.URL+"/v1/").
+			WithRetry(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+
+		response, err := cli.Latest(context.Background(), LatestRequest{})
+		assert.ErrorIs(t, err, ErrRetriesExhausted, "expected error to wrap ErrRetriesExhausted")
+		assert.Equal(t, LatestResponse{}, response, "expected response to be empty")
+	})
+}